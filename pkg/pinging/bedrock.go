@@ -0,0 +1,165 @@
+package pinging
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// raknetMagic is the fixed RakNet "offline message data ID" every
+// unconnected ping/pong must carry so the transport can be told apart from a
+// connected session.
+var raknetMagic = [16]byte{0x00, 0xff, 0xff, 0x00, 0xfe, 0xfe, 0xfe, 0xfe, 0xfd, 0xfd, 0xfd, 0xfd, 0x12, 0x34, 0x56, 0x78}
+
+const (
+	raknetUnconnectedPing = 0x01
+	raknetUnconnectedPong = 0x1c
+
+	defaultBedrockPort = 19132
+)
+
+// BedrockResponse - Response object from a Bedrock (MCPE/RakNet) server.
+type BedrockResponse struct {
+	Edition         string
+	MOTD            []string
+	ProtocolVersion int
+	Version         string
+	Online          int
+	Max             int
+	ServerID        string
+	Gamemode        string
+	IPv4Port        int
+	IPv6Port        int
+	Latency         time.Duration
+}
+
+// PingBedrock - Send an Unconnected Ping to a Bedrock Edition (RakNet)
+// Minecraft server and parse its Unconnected Pong MOTD string.
+func PingBedrock(address string, port int) (*BedrockResponse, error) {
+	host := net.JoinHostPort(address, strconv.Itoa(port))
+
+	conn, err := net.DialTimeout("udp", host, 3*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(3 * time.Second)); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+
+	if err := sendUnconnectedPing(conn); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 2048)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	latency := time.Since(start)
+
+	return parseUnconnectedPong(buf[:n], latency)
+}
+
+func sendUnconnectedPing(conn net.Conn) error {
+	pl := make([]byte, 0, 1+8+16+8)
+
+	// packet id
+	pl = append(pl, raknetUnconnectedPing)
+
+	// client timestamp (ms)
+	var timestamp [8]byte
+	binary.BigEndian.PutUint64(timestamp[:], uint64(time.Now().UnixNano()/int64(time.Millisecond)))
+	pl = append(pl, timestamp[:]...)
+
+	// magic
+	pl = append(pl, raknetMagic[:]...)
+
+	// client GUID
+	var guid [8]byte
+	binary.BigEndian.PutUint64(guid[:], uint64(time.Now().UnixNano()))
+	pl = append(pl, guid[:]...)
+
+	if _, err := conn.Write(pl); err != nil {
+		return errors.New("cannot write unconnected ping")
+	}
+
+	return nil
+}
+
+func parseUnconnectedPong(buf []byte, latency time.Duration) (*BedrockResponse, error) {
+	if len(buf) < 1+8+8+16+2 {
+		return nil, errors.New("unconnected pong too short")
+	}
+
+	if buf[0] != raknetUnconnectedPong {
+		return nil, errors.New("unexpected packet id")
+	}
+
+	// server timestamp (8 bytes) and server GUID (8 bytes) are not surfaced
+	// on BedrockResponse; skip straight to the magic and MOTD.
+	rest := buf[1+8+8:]
+
+	if !equalMagic(rest[:16]) {
+		return nil, errors.New("magic mismatch")
+	}
+	rest = rest[16:]
+
+	strLen := binary.BigEndian.Uint16(rest[:2])
+	rest = rest[2:]
+
+	if len(rest) < int(strLen) {
+		return nil, errors.New("motd shorter than declared length")
+	}
+
+	fields := strings.Split(string(rest[:strLen]), ";")
+	if len(fields) < 6 {
+		return nil, errors.New("motd missing required fields")
+	}
+
+	res := &BedrockResponse{
+		Edition: fields[0],
+		MOTD:    []string{fields[1]},
+		Latency: latency,
+	}
+
+	res.ProtocolVersion, _ = strconv.Atoi(fields[2])
+	res.Version = fields[3]
+	res.Online, _ = strconv.Atoi(fields[4])
+	res.Max, _ = strconv.Atoi(fields[5])
+
+	if len(fields) > 6 {
+		res.ServerID = fields[6]
+	}
+	if len(fields) > 7 {
+		res.MOTD = append(res.MOTD, fields[7])
+	}
+	if len(fields) > 8 {
+		res.Gamemode = fields[8]
+	}
+	if len(fields) > 10 {
+		res.IPv4Port, _ = strconv.Atoi(fields[10])
+	}
+	if len(fields) > 11 {
+		res.IPv6Port, _ = strconv.Atoi(fields[11])
+	}
+
+	return res, nil
+}
+
+func equalMagic(b []byte) bool {
+	for i, v := range raknetMagic {
+		if b[i] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package pinging
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// buildUnconnectedPong assembles a well-formed Unconnected Pong packet
+// carrying motd as its MOTD string, optionally corrupting it via mutate.
+func buildUnconnectedPong(motd string, mutate func([]byte) []byte) []byte {
+	buf := make([]byte, 0, 1+8+8+16+2+len(motd))
+
+	buf = append(buf, raknetUnconnectedPong)
+	buf = append(buf, make([]byte, 8)...) // server timestamp
+	buf = append(buf, make([]byte, 8)...) // server GUID
+	buf = append(buf, raknetMagic[:]...)
+
+	var strLen [2]byte
+	binary.BigEndian.PutUint16(strLen[:], uint16(len(motd)))
+	buf = append(buf, strLen[:]...)
+	buf = append(buf, motd...)
+
+	if mutate != nil {
+		buf = mutate(buf)
+	}
+	return buf
+}
+
+func TestParseUnconnectedPong(t *testing.T) {
+	t.Run("full MOTD", func(t *testing.T) {
+		motd := "MCPE;A Server;575;1.20.1;5;20;1234567890;A Sub Motd;Survival;1;19132;19133"
+		buf := buildUnconnectedPong(motd, nil)
+
+		res, err := parseUnconnectedPong(buf, 7*time.Millisecond)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if res.Edition != "MCPE" {
+			t.Errorf("Edition = %q, want MCPE", res.Edition)
+		}
+		if got := []string{res.MOTD[0], res.MOTD[1]}; got[0] != "A Server" || got[1] != "A Sub Motd" {
+			t.Errorf("MOTD = %v, want [A Server, A Sub Motd]", got)
+		}
+		if res.ProtocolVersion != 575 {
+			t.Errorf("ProtocolVersion = %d, want 575", res.ProtocolVersion)
+		}
+		if res.Version != "1.20.1" {
+			t.Errorf("Version = %q, want 1.20.1", res.Version)
+		}
+		if res.Online != 5 || res.Max != 20 {
+			t.Errorf("Online/Max = %d/%d, want 5/20", res.Online, res.Max)
+		}
+		if res.ServerID != "1234567890" {
+			t.Errorf("ServerID = %q, want 1234567890", res.ServerID)
+		}
+		if res.Gamemode != "Survival" {
+			t.Errorf("Gamemode = %q, want Survival", res.Gamemode)
+		}
+		if res.IPv4Port != 19132 || res.IPv6Port != 19133 {
+			t.Errorf("IPv4Port/IPv6Port = %d/%d, want 19132/19133", res.IPv4Port, res.IPv6Port)
+		}
+		if res.Latency != 7*time.Millisecond {
+			t.Errorf("Latency = %v, want 7ms", res.Latency)
+		}
+	})
+
+	t.Run("minimal MOTD without optional fields", func(t *testing.T) {
+		buf := buildUnconnectedPong("MCPE;A Server;575;1.20.1;5;20", nil)
+
+		res, err := parseUnconnectedPong(buf, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(res.MOTD) != 1 || res.MOTD[0] != "A Server" {
+			t.Errorf("MOTD = %v, want [A Server]", res.MOTD)
+		}
+		if res.ServerID != "" || res.Gamemode != "" || res.IPv4Port != 0 || res.IPv6Port != 0 {
+			t.Errorf("optional fields should be zero, got %+v", res)
+		}
+	})
+
+	t.Run("buffer shorter than fixed header", func(t *testing.T) {
+		_, err := parseUnconnectedPong([]byte{raknetUnconnectedPong, 0x01, 0x02}, 0)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("wrong packet id", func(t *testing.T) {
+		buf := buildUnconnectedPong("MCPE;A;1;1;1;1", func(b []byte) []byte {
+			b[0] = 0x00
+			return b
+		})
+
+		if _, err := parseUnconnectedPong(buf, 0); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("magic mismatch", func(t *testing.T) {
+		buf := buildUnconnectedPong("MCPE;A;1;1;1;1", func(b []byte) []byte {
+			b[1+8+8] ^= 0xFF
+			return b
+		})
+
+		if _, err := parseUnconnectedPong(buf, 0); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("declared length longer than actual data", func(t *testing.T) {
+		buf := buildUnconnectedPong("MCPE;A;1;1;1;1", func(b []byte) []byte {
+			lenOffset := 1 + 8 + 8 + 16
+			binary.BigEndian.PutUint16(b[lenOffset:], 0xFFFF)
+			return b
+		})
+
+		if _, err := parseUnconnectedPong(buf, 0); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("too few semicolon fields", func(t *testing.T) {
+		buf := buildUnconnectedPong("MCPE;A;1;1;1", nil)
+
+		if _, err := parseUnconnectedPong(buf, 0); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
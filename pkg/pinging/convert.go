@@ -0,0 +1,69 @@
+package pinging
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/synchthia/mcping/pkg/pinging/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// faviconDataURIPrefix is how the status response embeds the server icon.
+const faviconDataURIPrefix = "data:image/png;base64,"
+
+// ToProto converts r into its protobuf representation: the chat-component
+// Description is flattened to plain text, the favicon is decoded out of its
+// base64 data URI into raw bytes, and ObservedAt is stamped with now.
+func (r *PingResponse) ToProto() (*pb.PingResponse, error) {
+	favicon, err := decodeFavicon(r.Favicon)
+	if err != nil {
+		return nil, fmt.Errorf("decode favicon: %w", err)
+	}
+
+	return &pb.PingResponse{
+		Version: &pb.Version{
+			Name:     r.Version.Name,
+			Protocol: int32(r.Version.Protocol),
+		},
+		Players: &pb.Players{
+			Max:    int32(r.Players.Max),
+			Online: int32(r.Players.Online),
+			Sample: samplesToProto(r.Players.Sample),
+		},
+		Description: flattenDescription(r.Description),
+		Favicon:     favicon,
+		LatencyMs:   r.Latency.Milliseconds(),
+		ObservedAt:  timestamppb.Now(),
+	}, nil
+}
+
+func samplesToProto(samples []map[string]string) []*pb.Player {
+	players := make([]*pb.Player, 0, len(samples))
+	for _, s := range samples {
+		players = append(players, &pb.Player{Name: s["name"], Id: s["id"]})
+	}
+	return players
+}
+
+// flattenDescription renders a Description field down to plain text. It is
+// already a plain string for PingLegacy results; modern servers send a
+// Minecraft chat component, which at minimum carries a "text" key.
+func flattenDescription(description interface{}) string {
+	switch d := description.(type) {
+	case string:
+		return d
+	case map[string]interface{}:
+		if text, ok := d["text"].(string); ok {
+			return text
+		}
+	}
+	return fmt.Sprintf("%v", description)
+}
+
+func decodeFavicon(favicon string) ([]byte, error) {
+	if favicon == "" {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimPrefix(favicon, faviconDataURIPrefix))
+}
@@ -0,0 +1,147 @@
+package pinging
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// legacyProtocolVersion is sent in the 1.6 "MC|PingHost" plugin message; the
+// value itself is ignored by the server for this style of ping.
+const legacyProtocolVersion = 74
+
+// PingLegacy - Send a pre-1.7 Server List Ping (the 1.6 "MC|PingHost" two
+// packet exchange) to a Minecraft server that doesn't speak the modern
+// handshake. Thin wrapper around PingLegacyContext using
+// context.Background().
+func PingLegacy(address string, port int, opts ...Option) (*PingResponse, error) {
+	return PingLegacyContext(context.Background(), address, port, opts...)
+}
+
+// PingLegacyContext - Send a pre-1.7 Server List Ping, honoring ctx and the
+// same dial/read/write timeout Options as PingContext.
+func PingLegacyContext(ctx context.Context, address string, port int, opts ...Option) (*PingResponse, error) {
+	cfg := newPingConfig(opts)
+
+	conn, stop, err := dialWithCancel(ctx, cfg, fmt.Sprintf("%s:%d", address, port))
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+	defer stop()
+
+	if err := conn.SetWriteDeadline(deadlineFor(ctx, cfg.writeTimeout)); err != nil {
+		return nil, err
+	}
+
+	if err := sendLegacyPing(conn, address, port); err != nil {
+		return nil, err
+	}
+
+	if err := conn.SetReadDeadline(deadlineFor(ctx, cfg.readTimeout)); err != nil {
+		return nil, err
+	}
+
+	return readLegacyPong(conn)
+}
+
+func sendLegacyPing(conn net.Conn, address string, port int) error {
+	buf := &bytes.Buffer{}
+
+	// packet id: server list ping
+	buf.WriteByte(0xFE)
+	buf.WriteByte(0x01)
+
+	// packet id: plugin message
+	buf.WriteByte(0xFA)
+
+	channel := utf16BEEncode("MC|PingHost")
+	binary.Write(buf, binary.BigEndian, int16(len(channel)/2))
+	buf.Write(channel)
+
+	host := utf16BEEncode(address)
+	remaining := 1 + 2 + len(host) + 4
+	binary.Write(buf, binary.BigEndian, int16(remaining))
+
+	buf.WriteByte(legacyProtocolVersion)
+	binary.Write(buf, binary.BigEndian, int16(len(host)/2))
+	buf.Write(host)
+	binary.Write(buf, binary.BigEndian, int32(port))
+
+	if _, err := conn.Write(buf.Bytes()); err != nil {
+		return errors.New("cannot write legacy ping")
+	}
+
+	return nil
+}
+
+func readLegacyPong(conn net.Conn) (*PingResponse, error) {
+	r := bufio.NewReader(conn)
+
+	packetID, err := r.ReadByte()
+	if err != nil {
+		return nil, errors.New("could not read legacy pong packet id")
+	}
+	if packetID != 0xFF {
+		return nil, errors.New("unexpected legacy pong packet id")
+	}
+
+	var strLen uint16
+	if err := binary.Read(r, binary.BigEndian, &strLen); err != nil {
+		return nil, errors.New("could not read legacy pong length")
+	}
+
+	pl := make([]byte, int(strLen)*2)
+	if _, err := io.ReadFull(r, pl); err != nil {
+		return nil, errors.New("could not read legacy pong payload")
+	}
+
+	decoded := strings.TrimPrefix(utf16BEDecode(pl), "§1\x00")
+
+	fields := strings.Split(decoded, "\x00")
+	if len(fields) < 5 {
+		return nil, errors.New("legacy pong missing fields")
+	}
+
+	protocolVersion, _ := strconv.Atoi(fields[0])
+	online, _ := strconv.Atoi(fields[3])
+	max, _ := strconv.Atoi(fields[4])
+
+	return &PingResponse{
+		Version: Version{
+			Name:     fields[1],
+			Protocol: protocolVersion,
+		},
+		Players: Players{
+			Online: online,
+			Max:    max,
+		},
+		Description: fields[2],
+	}, nil
+}
+
+func utf16BEEncode(s string) []byte {
+	u16 := utf16.Encode([]rune(s))
+	buf := make([]byte, len(u16)*2)
+	for i, v := range u16 {
+		binary.BigEndian.PutUint16(buf[i*2:], v)
+	}
+	return buf
+}
+
+func utf16BEDecode(b []byte) string {
+	u16 := make([]uint16, len(b)/2)
+	for i := range u16 {
+		u16[i] = binary.BigEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u16))
+}
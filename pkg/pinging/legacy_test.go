@@ -0,0 +1,109 @@
+package pinging
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+func TestUTF16BERoundTrip(t *testing.T) {
+	for _, s := range []string{"", "hello", "mc.example.com", "héllo wörld", "日本語"} {
+		encoded := utf16BEEncode(s)
+		decoded := utf16BEDecode(encoded)
+		if decoded != s {
+			t.Errorf("round trip of %q: got %q", s, decoded)
+		}
+	}
+}
+
+// buildLegacyPong assembles a well-formed 1.6 legacy pong packet
+// (0xFF + UTF-16BE length-prefixed string) from its NUL-delimited
+// fields, i.e. everything after the "§1\x00" marker.
+func buildLegacyPong(fields string) []byte {
+	payload := "§1\x00" + fields
+	encoded := utf16BEEncode(payload)
+
+	buf := []byte{0xFF}
+	var strLen [2]byte
+	binary.BigEndian.PutUint16(strLen[:], uint16(len(encoded)/2))
+	buf = append(buf, strLen[:]...)
+	buf = append(buf, encoded...)
+	return buf
+}
+
+// readLegacyPongFrom feeds data through a net.Pipe so readLegacyPong can be
+// exercised against its real net.Conn-shaped signature.
+func readLegacyPongFrom(t *testing.T, data []byte) (*PingResponse, error) {
+	t.Helper()
+
+	client, server := net.Pipe()
+	go func() {
+		server.Write(data)
+		server.Close()
+	}()
+	defer client.Close()
+
+	return readLegacyPong(client)
+}
+
+func TestReadLegacyPong(t *testing.T) {
+	t.Run("valid response", func(t *testing.T) {
+		data := buildLegacyPong("127\x001.8\x00A server\x005\x0020")
+
+		res, err := readLegacyPongFrom(t, data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if res.Version.Protocol != 127 {
+			t.Errorf("Protocol = %d, want 127", res.Version.Protocol)
+		}
+		if res.Version.Name != "1.8" {
+			t.Errorf("Version.Name = %q, want 1.8", res.Version.Name)
+		}
+		if res.Description != "A server" {
+			t.Errorf("Description = %v, want %q", res.Description, "A server")
+		}
+		if res.Players.Online != 5 || res.Players.Max != 20 {
+			t.Errorf("Online/Max = %d/%d, want 5/20", res.Players.Online, res.Players.Max)
+		}
+	})
+
+	t.Run("wrong packet id", func(t *testing.T) {
+		data := buildLegacyPong("127\x001.8\x00A server\x005\x0020")
+		data[0] = 0x00
+
+		if _, err := readLegacyPongFrom(t, data); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("high-bit length does not panic", func(t *testing.T) {
+		// Regression test: the length used to be read as a signed int16, so
+		// a server-supplied 0x8000 produced a negative slice length and
+		// panicked instead of returning an error.
+		data := []byte{0xFF, 0x80, 0x00}
+
+		_, err := readLegacyPongFrom(t, data)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("truncated payload", func(t *testing.T) {
+		data := buildLegacyPong("127\x001.8\x00A server\x005\x0020")
+		data = data[:len(data)-4]
+
+		if _, err := readLegacyPongFrom(t, data); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		data := buildLegacyPong("127\x001.8")
+
+		if _, err := readLegacyPongFrom(t, data); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
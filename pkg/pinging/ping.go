@@ -3,13 +3,14 @@ package pinging
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
-	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,10 +20,11 @@ const (
 
 // PingResponse - Response object from Minecraft server
 type PingResponse struct {
-	Version     Version     `json:"version"`
-	Players     Players     `json:"players"`
-	Description interface{} `json:"description"`
-	Favicon     string      `json:"favicon"`
+	Version     Version       `json:"version"`
+	Players     Players       `json:"players"`
+	Description interface{}   `json:"description"`
+	Favicon     string        `json:"favicon"`
+	Latency     time.Duration `json:"latency"`
 }
 
 // Version - Server version
@@ -38,17 +40,194 @@ type Players struct {
 	Sample []map[string]string
 }
 
-// Ping - Send ping to Minecraft server
-func Ping(address string, port int) (*PingResponse, error) {
-	host := fmt.Sprintf("%s:%d", address, port)
-	conn, err := net.DialTimeout("tcp", host, 3*time.Second)
+// defaultTimeout is used for dialing and for read/write deadlines when the
+// caller doesn't override them and the context carries no deadline of its
+// own.
+const defaultTimeout = 3 * time.Second
+
+// Option configures Ping and PingContext.
+type Option func(*pingConfig)
+
+type pingConfig struct {
+	legacyFallback bool
+	dialTimeout    time.Duration
+	readTimeout    time.Duration
+	writeTimeout   time.Duration
+	dialer         *net.Dialer
+}
+
+func newPingConfig(opts []Option) *pingConfig {
+	cfg := &pingConfig{
+		dialTimeout:  defaultTimeout,
+		readTimeout:  defaultTimeout,
+		writeTimeout: defaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithLegacyFallback retries with PingLegacy when the modern handshake
+// fails, which is the behavior most monitoring tools want since it saves
+// callers from having to special-case old or deliberately legacy servers.
+func WithLegacyFallback() Option {
+	return func(c *pingConfig) {
+		c.legacyFallback = true
+	}
+}
+
+// WithDialTimeout overrides how long dialing the server may take when the
+// context carries no earlier deadline.
+func WithDialTimeout(d time.Duration) Option {
+	return func(c *pingConfig) {
+		c.dialTimeout = d
+	}
+}
+
+// WithReadTimeout overrides how long reading the status/pong response may
+// take when the context carries no earlier deadline.
+func WithReadTimeout(d time.Duration) Option {
+	return func(c *pingConfig) {
+		c.readTimeout = d
+	}
+}
+
+// WithWriteTimeout overrides how long writing the handshake/status request
+// may take when the context carries no earlier deadline.
+func WithWriteTimeout(d time.Duration) Option {
+	return func(c *pingConfig) {
+		c.writeTimeout = d
+	}
+}
+
+// WithDialer lets callers supply their own *net.Dialer, e.g. to bind a
+// source address or customize the dial's own Timeout/Resolver. When set,
+// WithDialTimeout is ignored in favor of the dialer's own configuration.
+func WithDialer(dialer *net.Dialer) Option {
+	return func(c *pingConfig) {
+		c.dialer = dialer
+	}
+}
+
+// Ping - Send ping to Minecraft server. Thin wrapper around PingContext
+// using context.Background().
+func Ping(address string, port int, opts ...Option) (*PingResponse, error) {
+	return PingContext(context.Background(), address, port, opts...)
+}
+
+// PingContext - Send ping to a Minecraft server, honoring ctx for dialing
+// and for bounding the whole exchange; a canceled or expired ctx unblocks a
+// reader stuck waiting on a stalled server.
+func PingContext(ctx context.Context, address string, port int, opts ...Option) (*PingResponse, error) {
+	cfg := newPingConfig(opts)
+
+	pong, err := pingAddr(ctx, cfg, fmt.Sprintf("%s:%d", address, port), address, port)
+	if err != nil && cfg.legacyFallback {
+		return PingLegacyContext(ctx, address, port, opts...)
+	}
+
+	return pong, err
+}
+
+// defaultMinecraftPort is the Server List Ping port used when a host has no
+// SRV record.
+const defaultMinecraftPort = 25565
+
+// PingHostOption configures PingHost.
+type PingHostOption func(*pingHostConfig)
+
+type pingHostConfig struct {
+	port     int
+	resolver *net.Resolver
+}
+
+// WithPort skips SRV resolution and pings host on the given port directly.
+func WithPort(port int) PingHostOption {
+	return func(c *pingHostConfig) {
+		c.port = port
+	}
+}
+
+// WithResolver overrides the resolver used to look up the `_minecraft._tcp`
+// SRV record, e.g. for tests or split-horizon DNS.
+func WithResolver(resolver *net.Resolver) PingHostOption {
+	return func(c *pingHostConfig) {
+		c.resolver = resolver
+	}
+}
+
+// PingHost - Send ping to a Minecraft server given only its hostname,
+// resolving the `_minecraft._tcp` SRV record the way vanilla clients do so
+// that hosts like mc.hypixel.net work without an explicit port. Falls back
+// to the bare host on defaultMinecraftPort when no SRV record is present.
+func PingHost(ctx context.Context, host string, opts ...PingHostOption) (*PingResponse, error) {
+	cfg := &pingHostConfig{resolver: net.DefaultResolver}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	target, port := host, defaultMinecraftPort
+
+	switch {
+	case cfg.port != 0:
+		port = cfg.port
+	default:
+		if _, addrs, err := cfg.resolver.LookupSRV(ctx, "minecraft", "tcp", host); err == nil && len(addrs) > 0 {
+			target = strings.TrimSuffix(addrs[0].Target, ".")
+			port = int(addrs[0].Port)
+		}
+	}
+
+	// Dial the resolved target, but keep the virtual hostname the user typed
+	// in the handshake: shared hosts behind a reverse proxy (e.g. BungeeCord)
+	// reject or misroute the ping otherwise.
+	return pingAddr(ctx, newPingConfig(nil), fmt.Sprintf("%s:%d", target, port), host, port)
+}
+
+// dialWithCancel dials dialAddr under cfg's dialer/timeout and starts a
+// goroutine that closes the connection as soon as ctx is canceled, since
+// SetDeadline alone can't observe context cancellation and a reader blocked
+// in bufio needs something to unblock it. The returned stop func must be
+// called (typically via defer) once the connection is no longer in use, to
+// let the goroutine exit.
+func dialWithCancel(ctx context.Context, cfg *pingConfig, dialAddr string) (net.Conn, func(), error) {
+	dialer := cfg.dialer
+	if dialer == nil {
+		dialer = &net.Dialer{Timeout: cfg.dialTimeout}
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", dialAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	return conn, func() { close(done) }, nil
+}
+
+func pingAddr(ctx context.Context, cfg *pingConfig, dialAddr, handshakeHost string, handshakePort int) (*PingResponse, error) {
+	conn, stop, err := dialWithCancel(ctx, cfg, dialAddr)
 	if err != nil {
 		return nil, err
 	}
 
 	defer conn.Close()
+	defer stop()
+
+	if err := conn.SetWriteDeadline(deadlineFor(ctx, cfg.writeTimeout)); err != nil {
+		return nil, err
+	}
 
-	if err := sendHandShake(conn, host); err != nil {
+	if err := sendHandShake(conn, handshakeHost, handshakePort); err != nil {
 		return nil, err
 	}
 
@@ -56,15 +235,94 @@ func Ping(address string, port int) (*PingResponse, error) {
 		return nil, err
 	}
 
-	pong, err := readPong(conn)
+	if err := conn.SetReadDeadline(deadlineFor(ctx, cfg.readTimeout)); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+
+	pong, err := readPong(r)
 	if err != nil {
 		return nil, err
 	}
 
+	// Second phase: probe round-trip latency on the same connection. Older
+	// or modded servers may close the connection right after the status
+	// response, so a failure here just leaves the latency unknown.
+	writeDeadline := deadlineFor(ctx, cfg.writeTimeout)
+	readDeadline := deadlineFor(ctx, cfg.readTimeout)
+	if latency, err := pingPong(conn, r, writeDeadline, readDeadline); err == nil {
+		pong.Latency = latency
+	}
+
 	return pong, nil
 }
 
-func sendHandShake(conn net.Conn, host string) error {
+// deadlineFor returns the earlier of ctx's own deadline (if any) and
+// time.Now()+d, so an Option timeout never extends a deadline the caller
+// already imposed via context.
+func deadlineFor(ctx context.Context, d time.Duration) time.Time {
+	deadline := time.Now().Add(d)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		return ctxDeadline
+	}
+	return deadline
+}
+
+// pingPong sends a Server List Ping "ping" packet carrying the current time
+// and waits for the matching "pong" echo, returning the round-trip time.
+// writeDeadline and readDeadline are applied separately so a short
+// WithWriteTimeout doesn't also starve the read side of the probe.
+func pingPong(conn net.Conn, r *bufio.Reader, writeDeadline, readDeadline time.Time) (time.Duration, error) {
+	payload := time.Now().UnixNano()
+
+	pl := &bytes.Buffer{}
+
+	// packet id
+	pl.WriteByte(0x01)
+
+	// payload
+	binary.Write(pl, binary.BigEndian, payload)
+
+	if err := conn.SetWriteDeadline(writeDeadline); err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+
+	if err := writePacket(conn, pl); err != nil {
+		return 0, errors.New("cannot write ping")
+	}
+
+	if err := conn.SetReadDeadline(readDeadline); err != nil {
+		return 0, err
+	}
+
+	res, err := readPacket(r)
+	if err != nil {
+		return 0, errors.New("could not read pong")
+	}
+
+	elapsed := time.Since(start)
+
+	// packet id
+	_, n := binary.Uvarint(res)
+	if n <= 0 {
+		return 0, errors.New("could not read pong packet id")
+	}
+
+	if len(res[n:]) != 8 {
+		return 0, errors.New("unexpected pong payload size")
+	}
+
+	if echoed := int64(binary.BigEndian.Uint64(res[n:])); echoed != payload {
+		return 0, errors.New("pong payload did not match ping")
+	}
+
+	return elapsed, nil
+}
+
+func sendHandShake(conn net.Conn, host string, port int) error {
 	pl := &bytes.Buffer{}
 
 	// packet id
@@ -74,25 +332,16 @@ func sendHandShake(conn net.Conn, host string) error {
 	pl.WriteByte(protocolVersion)
 
 	// server address
-	host, port, err := net.SplitHostPort(host)
-	if err != nil {
-		panic(err)
-	}
-
 	pl.Write(encodeVarint(uint64(len(host))))
 	pl.WriteString(host)
 
 	// server port
-	iPort, err := strconv.Atoi(port)
-	if err != nil {
-		panic(err)
-	}
-	binary.Write(pl, binary.BigEndian, int16(iPort))
+	binary.Write(pl, binary.BigEndian, int16(port))
 
 	// next state (status)
 	pl.WriteByte(0x01)
 
-	if _, err := makePacket(pl).WriteTo(conn); err != nil {
+	if err := writePacket(conn, pl); err != nil {
 		return errors.New("cannot write handshake")
 	}
 
@@ -105,24 +354,17 @@ func sendStatusRequest(conn net.Conn) error {
 	// send request zero
 	pl.WriteByte(0x00)
 
-	if _, err := makePacket(pl).WriteTo(conn); err != nil {
+	if err := writePacket(conn, pl); err != nil {
 		return errors.New("cannot write send status request")
 	}
 
 	return nil
 }
 
-func readPong(rd io.Reader) (*PingResponse, error) {
-	r := bufio.NewReader(rd)
-	nl, err := binary.ReadUvarint(r)
-	if err != nil {
-		return nil, errors.New("could not read length")
-	}
-
-	pl := make([]byte, nl)
-	_, err = io.ReadFull(r, pl)
+func readPong(r *bufio.Reader) (*PingResponse, error) {
+	pl, err := readPacket(r)
 	if err != nil {
-		return nil, errors.New("could not read length given by length header")
+		return nil, err
 	}
 
 	// packet id
@@ -158,6 +400,28 @@ func encodeVarint(x uint64) []byte {
 	return buf[0:n]
 }
 
+// writePacket frames pl with its varint length prefix and writes it to w.
+func writePacket(w io.Writer, pl *bytes.Buffer) error {
+	_, err := makePacket(pl).WriteTo(w)
+	return err
+}
+
+// readPacket reads a single varint-length-prefixed packet from r and returns
+// its payload (packet id + data), without consuming bytes beyond the frame.
+func readPacket(r *bufio.Reader) ([]byte, error) {
+	nl, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, errors.New("could not read length")
+	}
+
+	pl := make([]byte, nl)
+	if _, err := io.ReadFull(r, pl); err != nil {
+		return nil, errors.New("could not read length given by length header")
+	}
+
+	return pl, nil
+}
+
 func makePacket(pl *bytes.Buffer) *bytes.Buffer {
 	var buf bytes.Buffer
 	// get payload length
@@ -0,0 +1,109 @@
+// Package protoio provides varint-length-delimited Protobuf readers and
+// writers, the standard Go pattern for streaming multiple messages over one
+// connection or file without a framing layer of its own.
+package protoio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DelimitedWriter writes a varint length prefix followed by the marshaled
+// message, so multiple messages can be written back-to-back to the same
+// io.Writer and later split apart again by DelimitedReader.
+type DelimitedWriter interface {
+	WriteMsg(msg proto.Message) (int, error)
+}
+
+// NewDelimitedWriter returns a DelimitedWriter that writes to w.
+func NewDelimitedWriter(w io.Writer) DelimitedWriter {
+	return &delimitedWriter{w: w}
+}
+
+type delimitedWriter struct {
+	w      io.Writer
+	lenBuf [binary.MaxVarintLen64]byte
+}
+
+func (w *delimitedWriter) WriteMsg(msg proto.Message) (int, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return 0, err
+	}
+
+	n := binary.PutUvarint(w.lenBuf[:], uint64(len(data)))
+	if _, err := w.w.Write(w.lenBuf[:n]); err != nil {
+		return 0, err
+	}
+
+	written, err := w.w.Write(data)
+	return n + written, err
+}
+
+// DelimitedReader reads one varint-length-prefixed message at a time,
+// unmarshaling it into msg.
+type DelimitedReader interface {
+	ReadMsg(msg proto.Message) error
+}
+
+// NewDelimitedReader returns a DelimitedReader that reads from r. It reads
+// exactly the bytes of each frame - never more - so multiple messages can
+// share the same underlying io.Reader (e.g. a pipe) without an internal
+// buffer silently consuming bytes that belong to the next message. maxSize
+// bounds how large a single message may declare itself to be, guarding
+// against a corrupt or hostile length prefix.
+func NewDelimitedReader(r io.Reader, maxSize int) DelimitedReader {
+	return &delimitedReader{r: r, maxSize: maxSize}
+}
+
+type delimitedReader struct {
+	r       io.Reader
+	maxSize int
+}
+
+func (r *delimitedReader) ReadMsg(msg proto.Message) error {
+	length, err := readUvarint(r.r)
+	if err != nil {
+		return err
+	}
+
+	if length > uint64(r.maxSize) {
+		return errors.New("protoio: message size exceeds maxSize")
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(data, msg)
+}
+
+// readUvarint reads a binary.Uvarint one byte at a time rather than through
+// a bufio.Reader, which is what lets DelimitedReader avoid reading past the
+// end of the current frame. Like binary.ReadUvarint, it gives up after
+// binary.MaxVarintLen64 bytes rather than looping forever on a peer that
+// keeps setting the continuation bit.
+func readUvarint(r io.Reader) (uint64, error) {
+	var x uint64
+	var s uint
+
+	b := make([]byte, 1)
+	for i := 0; i < binary.MaxVarintLen64; i++ {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return 0, err
+		}
+
+		if b[0] < 0x80 {
+			return x | uint64(b[0])<<s, nil
+		}
+
+		x |= uint64(b[0]&0x7f) << s
+		s += 7
+	}
+
+	return 0, errors.New("protoio: varint overflows a 64-bit integer")
+}
@@ -0,0 +1,125 @@
+package protoio
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/synchthia/mcping/pkg/pinging/pb"
+)
+
+func TestReadUvarint(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      []byte
+		want    uint64
+		wantErr bool
+	}{
+		{name: "zero", in: []byte{0x00}, want: 0},
+		{name: "single byte", in: []byte{0x7f}, want: 127},
+		{name: "two bytes", in: []byte{0xac, 0x02}, want: 300},
+		{name: "max uint64, ten bytes", in: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, want: ^uint64(0)},
+		{name: "truncated", in: []byte{0x80}, wantErr: true},
+		{name: "overflow, ten continuation bytes", in: []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x01}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := readUvarint(bytes.NewReader(c.in))
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got value %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("got %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func TestReadUvarintNeverBlocksOnInfiniteContinuationBits(t *testing.T) {
+	// Regression test: readUvarint used to loop forever on a peer that kept
+	// the continuation bit set, before it gained the 10-byte cap.
+	r := io.NopCloser(repeatingReader{b: 0x80})
+	if _, err := readUvarint(r); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+type repeatingReader struct{ b byte }
+
+func (r repeatingReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = r.b
+	}
+	return len(p), nil
+}
+
+func TestDelimitedWriterReader(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewDelimitedWriter(&buf)
+
+	first := &pb.Version{Name: "1.20.1", Protocol: 763}
+	second := &pb.Version{Name: "1.8", Protocol: 47}
+
+	if _, err := w.WriteMsg(first); err != nil {
+		t.Fatalf("WriteMsg(first): %v", err)
+	}
+	if _, err := w.WriteMsg(second); err != nil {
+		t.Fatalf("WriteMsg(second): %v", err)
+	}
+
+	r := NewDelimitedReader(&buf, 1<<20)
+
+	var gotFirst pb.Version
+	if err := r.ReadMsg(&gotFirst); err != nil {
+		t.Fatalf("ReadMsg(first): %v", err)
+	}
+	if gotFirst.Name != first.Name || gotFirst.Protocol != first.Protocol {
+		t.Errorf("first = %+v, want %+v", &gotFirst, first)
+	}
+
+	var gotSecond pb.Version
+	if err := r.ReadMsg(&gotSecond); err != nil {
+		t.Fatalf("ReadMsg(second): %v", err)
+	}
+	if gotSecond.Name != second.Name || gotSecond.Protocol != second.Protocol {
+		t.Errorf("second = %+v, want %+v", &gotSecond, second)
+	}
+}
+
+func TestDelimitedReaderRejectsOversizedMessage(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewDelimitedWriter(&buf)
+	if _, err := w.WriteMsg(&pb.Version{Name: strings.Repeat("x", 100)}); err != nil {
+		t.Fatalf("WriteMsg: %v", err)
+	}
+
+	r := NewDelimitedReader(&buf, 4)
+	var out pb.Version
+	if err := r.ReadMsg(&out); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestDelimitedReaderTruncatedStream(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewDelimitedWriter(&buf)
+	if _, err := w.WriteMsg(&pb.Version{Name: "1.20.1", Protocol: 763}); err != nil {
+		t.Fatalf("WriteMsg: %v", err)
+	}
+
+	truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-2])
+	r := NewDelimitedReader(truncated, 1<<20)
+
+	var out pb.Version
+	if err := r.ReadMsg(&out); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,35 @@
+package pinging
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/synchthia/mcping/pkg/pinging/protoio"
+)
+
+// PingStream pings each address in hosts on port and writes its result as a
+// varint-delimited pb.PingResponse to w, e.g. for `mcping --proto |
+// downstream-consumer` pipelines. A host that fails to respond is skipped
+// rather than aborting the whole stream, so one dead server in a fleet
+// doesn't stop reporting on the rest.
+func PingStream(w io.Writer, hosts []string, port int, opts ...Option) error {
+	dw := protoio.NewDelimitedWriter(w)
+
+	for _, host := range hosts {
+		pong, err := Ping(host, port, opts...)
+		if err != nil {
+			continue
+		}
+
+		msg, err := pong.ToProto()
+		if err != nil {
+			return fmt.Errorf("%s: %w", host, err)
+		}
+
+		if _, err := dw.WriteMsg(msg); err != nil {
+			return fmt.Errorf("%s: %w", host, err)
+		}
+	}
+
+	return nil
+}